@@ -7,6 +7,7 @@ import (
 	"io"
 	"log/slog"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +19,11 @@ const (
 	trimChars = " " + `"` + "\t" + "\n"
 
 	maxTracks = 99
+
+	// framesPerSecond is the number of CD frames (sectors) per second, as defined by Redbook.
+	framesPerSecond = 75
+
+	catalogLength = 13
 )
 
 type Command struct {
@@ -34,6 +40,25 @@ var TrackIndexCommand = Command{Name: "INDEX", ExactParams: 2}
 var RemCommand = Command{Name: "REM", MinParams: 1}
 var RemGenreCommand = Command{Name: "GENRE", MinParams: 1}
 var RemDateCommand = Command{Name: "DATE", MinParams: 1}
+var CatalogCommand = Command{Name: "CATALOG", ExactParams: 1}
+var CdTextFileCommand = Command{Name: "CDTEXTFILE", MinParams: 1}
+var SongwriterCommand = Command{Name: "SONGWRITER", MinParams: 1}
+var IsrcCommand = Command{Name: "ISRC", ExactParams: 1}
+var FlagsCommand = Command{Name: "FLAGS", MinParams: 1}
+var PregapCommand = Command{Name: "PREGAP", ExactParams: 1}
+var PostgapCommand = Command{Name: "POSTGAP", ExactParams: 1}
+
+// isrcPattern matches an International Standard Recording Code: two letters for the
+// country, three alphanumerics for the registrant, two digits for the year, five digits
+// for the designation code.
+var isrcPattern = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}\d{7}$`)
+
+// catalogPattern matches a UPC/EAN CATALOG number: exactly 13 digits. strconv.Atoi would
+// also accept a leading +/-, which a UPC/EAN never has.
+var catalogPattern = regexp.MustCompile(`^\d{13}$`)
+
+// validFlags are the data flags defined by the Redbook standard, as used by the FLAGS command.
+var validFlags = map[string]bool{"DCP": true, "PRE": true, "SCMS": true, "4CH": true}
 
 type IndexPoint struct {
 	Frame     int
@@ -43,53 +68,139 @@ type IndexPoint struct {
 // Track represents a single track in a cue sheet file.
 // Required fields: Index01, Type.
 type Track struct {
-	Title   string
-	Type    string
-	Index01 IndexPoint
+	Title      string
+	Performer  string
+	Songwriter string
+	Type       string
+	ISRC       string
+	Flags      []string
+	Pregap     time.Duration
+	Postgap    time.Duration
+	// HasIndex00 reports whether INDEX 00 was present - needed because its zero value
+	// (timestamp 0, frame 0) is also a valid index point (the track-1 pregap marker).
+	HasIndex00 bool
+	Index00    IndexPoint
+	Index01    IndexPoint
+}
+
+// FileRef represents a single FILE command and the tracks that belong to it. Cue sheets
+// shipped alongside one audio file per track (for gapless rips) declare a new FILE before
+// each TRACK; single-file cue sheets just have one.
+type FileRef struct {
+	Name   string
+	Format string
+	Tracks []*Track
 }
 
 // CueSheet represents the contents of a cue sheet file.
-// Required fields: FileName, Format, Tracks.
+// Required fields: Files, with each FileRef requiring Name, Format, Tracks.
 type CueSheet struct {
-	AlbumPerformer string
-	AlbumTitle     string
-	Date           string
-	Format         string
-	FileName       string
-	Genre          string
-	Tracks         []*Track
+	AlbumPerformer  string
+	AlbumSongwriter string
+	AlbumTitle      string
+	Catalog         string
+	CDTextFile      string
+	Date            string
+	Genre           string
+	Files           []*FileRef
+
+	// Format and FileName mirror Files[0].Format/Name for the common single-FILE case.
+	// They are left empty for multi-FILE cue sheets - use Files directly instead.
+	Format   string
+	FileName string
+
+	// Tracks is a flattened, convenience view of every track across all Files, in order.
+	// See AllTracks.
+	Tracks []*Track
+}
+
+// AllTracks returns the tracks of every FileRef in the cue sheet, in order. It backs the
+// flattened CueSheet.Tracks view.
+func (c *CueSheet) AllTracks() []*Track {
+	var tracks []*Track
+	for _, file := range c.Files {
+		tracks = append(tracks, file.Tracks...)
+	}
+	return tracks
+}
+
+// trackCount returns the total number of tracks parsed so far, across all files.
+func (c *CueSheet) trackCount() int {
+	count := 0
+	for _, file := range c.Files {
+		count += len(file.Tracks)
+	}
+	return count
+}
+
+// lastTrack returns the most recently parsed track, or nil if no TRACK command has been
+// seen yet.
+func (c *CueSheet) lastTrack() *Track {
+	if len(c.Files) == 0 {
+		return nil
+	}
+	currentFile := c.Files[len(c.Files)-1]
+	if len(currentFile.Tracks) == 0 {
+		return nil
+	}
+	return currentFile.Tracks[len(currentFile.Tracks)-1]
 }
 
 // Parse reads the cue sheet data from the provided reader and returns a parsed CueSheet struct.
 func Parse(reader io.Reader) (*CueSheet, error) {
-	bomReader := bufio.NewReader(reader)
-	maybeBom, _, err := bomReader.ReadRune()
+	return parseCueSheet(reader, Options{})
+}
+
+// ParseWithOptions parses like Parse, but falls back to decoding with opts.Encoding when
+// the input carries no byte-order mark. Use it for cue sheets from Windows ripping tools
+// that ship as legacy Shift-JIS or CP1252 without a BOM, e.g.
+// ParseWithOptions(r, Options{Encoding: japanese.ShiftJIS}).
+func ParseWithOptions(reader io.Reader, opts Options) (*CueSheet, error) {
+	return parseCueSheet(reader, opts)
+}
+
+func parseCueSheet(reader io.Reader, opts Options) (*CueSheet, error) {
+	decoded, err := decodeReader(reader, opts)
 	if err != nil {
-		return nil, fmt.Errorf("error reading first rune: %s", err)
-	}
-	if maybeBom != 65279 { // UTF-8 BOM, see https://en.wikipedia.org/wiki/Byte_order_mark#Byte-order_marks_by_encoding
-		bomReader.UnreadRune()
+		return nil, fmt.Errorf("error decoding cue sheet: %w", err)
 	}
-	// TODO: add other BOMs (UTF-16 etc)
 
-	scanner := bufio.NewScanner(bomReader)
-	c := &CueSheet{Tracks: []*Track{}}
+	scanner := bufio.NewScanner(decoded)
+	c := &CueSheet{}
 
 	var lineNr int
 	for scanner.Scan() {
-		line := strings.Trim(scanner.Text(), trimChars)
+		rawLine := scanner.Text()
+		line := strings.Trim(rawLine, trimChars)
 		lineNr++
 		if line == "" || line == "REM" {
 			continue
 		}
 		if err := c.parseLine(line); err != nil {
-			return nil, fmt.Errorf("line %d:\t%s:\n\t%w", lineNr, line, err)
+			return nil, &ParseError{
+				Line:        lineNr,
+				Column:      parameterColumn(rawLine),
+				Command:     strings.ToUpper(strings.Fields(line)[0]),
+				TrackNumber: c.trackCount(),
+				Err:         err,
+			}
 		}
 	}
 	if err := c.validate(); err != nil {
-		return nil, fmt.Errorf("invalid cue sheet: %w", err)
+		return nil, &ParseError{
+			Line:        lineNr,
+			Column:      1,
+			Command:     "validation",
+			TrackNumber: c.trackCount(),
+			Err:         fmt.Errorf("invalid cue sheet: %w", err),
+		}
+	}
+	c.Tracks = c.AllTracks()
+	if len(c.Files) == 1 {
+		c.FileName = c.Files[0].Name
+		c.Format = c.Files[0].Format
 	}
-	slog.Info("cue sheet parsed correctly", "lines", lineNr, "file", c.FileName, "format", c.Format, "tracks", len(c.Tracks))
+	slog.Info("cue sheet parsed correctly", "lines", lineNr, "files", len(c.Files), "tracks", len(c.Tracks))
 	return c, nil
 }
 
@@ -106,11 +217,25 @@ func (c *CueSheet) parseLine(line string) error {
 	case TrackCommand.Name:
 		err = c.parseTrack(parameters)
 	case TrackIndexCommand.Name:
-		err = c.parseTrackIndex01(parameters)
+		err = c.parseTrackIndex(parameters)
 	case TitleCommand.Name:
 		err = c.parseTitle(parameters)
 	case RemCommand.Name:
 		err = c.parseRem(parameters)
+	case CatalogCommand.Name:
+		err = c.parseCatalog(parameters)
+	case CdTextFileCommand.Name:
+		err = c.parseCDTextFile(parameters)
+	case SongwriterCommand.Name:
+		err = c.parseSongwriter(parameters)
+	case IsrcCommand.Name:
+		err = c.parseISRC(parameters)
+	case FlagsCommand.Name:
+		err = c.parseFlags(parameters)
+	case PregapCommand.Name:
+		err = c.parsePregap(parameters)
+	case PostgapCommand.Name:
+		err = c.parsePostgap(parameters)
 	default:
 		return fmt.Errorf("unexpected command: %s", command)
 	}
@@ -134,17 +259,22 @@ func parseString(val string, field *string) error {
 	return assignValue(val, field)
 }
 
+// parseFile starts a new FileRef. Cue sheets with one audio file per track repeat the
+// FILE command before every TRACK, so - unlike the other singleton fields - a repeated
+// FILE is not an error: it just means the tracks that follow belong to a new file.
 func (c *CueSheet) parseFile(parameters []string) error {
 	if err := FileCommand.validateParameters(len(parameters)); err != nil {
 		return fmt.Errorf("invalid FILE parameters: %w", err)
 	}
 	last := len(parameters) - 1
-	if err := parseString(parameters[last], &c.Format); err != nil {
+	file := &FileRef{}
+	if err := parseString(parameters[last], &file.Format); err != nil {
 		return fmt.Errorf("error parsing FILE format: %w", err)
 	}
-	if err := parseString(strings.Join(parameters[:last], " "), &c.FileName); err != nil {
+	if err := parseString(strings.Join(parameters[:last], " "), &file.Name); err != nil {
 		return fmt.Errorf("error parsing FILE name: %w", err)
 	}
+	c.Files = append(c.Files, file)
 	return nil
 }
 
@@ -152,8 +282,17 @@ func (c *CueSheet) parsePerformer(parameters []string) error {
 	if err := PerformerCommand.validateParameters(len(parameters)); err != nil {
 		return fmt.Errorf("invalid PERFORMER parameters: %w", err)
 	}
-	if err := parseString(strings.Join(parameters, " "), &c.AlbumPerformer); err != nil {
-		return fmt.Errorf("error parsing PERFORMER parameters: %w", err)
+	nrTracks := c.trackCount()
+	if nrTracks == 0 {
+		// no tracks yet - try setting album performer
+		if err := parseString(strings.Join(parameters, " "), &c.AlbumPerformer); err != nil {
+			return fmt.Errorf("error parsing album PERFORMER: %w", err)
+		}
+		return nil
+	}
+	currentTrack := c.lastTrack()
+	if err := parseString(strings.Join(parameters, " "), &currentTrack.Performer); err != nil {
+		return fmt.Errorf("error parsing track %d PERFORMER: %w", nrTracks-1, err)
 	}
 	return nil
 }
@@ -168,12 +307,16 @@ func (c *CueSheet) parseTrack(parameters []string) error {
 	if err := c.isNextTrack(nr); err != nil {
 		return fmt.Errorf("invalid track number: %w", err)
 	}
+	if len(c.Files) == 0 {
+		return errors.New("TRACK command must follow a FILE command")
+	}
 
 	var track Track
 	if err := parseString(typ, &track.Type); err != nil {
 		return fmt.Errorf("error parsing track type: %w", err)
 	}
-	c.Tracks = append(c.Tracks, &track)
+	currentFile := c.Files[len(c.Files)-1]
+	currentFile.Tracks = append(currentFile.Tracks, &track)
 	return nil
 }
 
@@ -182,7 +325,7 @@ func (c *CueSheet) isNextTrack(nr string) error {
 	if err != nil {
 		return fmt.Errorf("failed to parse track number: %w", err)
 	}
-	nextTrackNr := len(c.Tracks) + 1
+	nextTrackNr := c.trackCount() + 1
 	if trackNr != nextTrackNr {
 		return fmt.Errorf("expected track number %d, got %d", nextTrackNr, trackNr)
 	}
@@ -192,7 +335,7 @@ func (c *CueSheet) isNextTrack(nr string) error {
 	return nil
 }
 
-func (c *CueSheet) parseTrackIndex01(parameters []string) error {
+func (c *CueSheet) parseTrackIndex(parameters []string) error {
 	if err := TrackIndexCommand.validateParameters(len(parameters)); err != nil {
 		return fmt.Errorf("invalid TRACK INDEX parameters: %w", err)
 	}
@@ -203,25 +346,71 @@ func (c *CueSheet) parseTrackIndex01(parameters []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to parse index number: %w", err)
 	}
-	if indexNr != 1 {
+	if indexNr != 0 && indexNr != 1 {
 		return fmt.Errorf("expected index number 1, got %d", indexNr)
 	}
 
-	var minutes, seconds, frames int
-	if _, err = fmt.Sscanf(indexPoint, "%2d:%2d:%2d", &minutes, &seconds, &frames); err != nil {
+	minutes, seconds, frames, err := parseTimecode(indexPoint)
+	if err != nil {
 		return fmt.Errorf("error parsing timestamp and frame: %w", err)
 	}
 	duration := time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
 	index := IndexPoint{Timestamp: duration, Frame: frames}
-	lastTrack := c.Tracks[len(c.Tracks)-1]
+	lastTrack := c.lastTrack()
+	if lastTrack == nil {
+		return errors.New("INDEX command must follow a TRACK command")
+	}
+	if indexNr == 0 {
+		if lastTrack.HasIndex00 {
+			return fmt.Errorf("field already set: %v", lastTrack.Index00)
+		}
+		lastTrack.Index00 = index
+		lastTrack.HasIndex00 = true
+		return nil
+	}
 	return assignValue(index, &lastTrack.Index01)
 }
 
+// parameterColumn returns the 1-based column of rawLine's first parameter - the token
+// after the command name - falling back to the command's own column if the line has no
+// parameters. This is where most command errors (bad value, wrong format) actually point.
+func parameterColumn(rawLine string) int {
+	trimmed := strings.TrimLeft(rawLine, trimChars)
+	leading := len(rawLine) - len(trimmed)
+	spaceIdx := strings.IndexAny(trimmed, " \t")
+	if spaceIdx < 0 {
+		return leading + 1
+	}
+	afterCommand := trimmed[spaceIdx:]
+	spacing := len(afterCommand) - len(strings.TrimLeft(afterCommand, trimChars))
+	return leading + spaceIdx + spacing + 1
+}
+
+// parseTimecode parses a Redbook mm:ss:ff timecode into its components.
+func parseTimecode(s string) (minutes, seconds, frames int, err error) {
+	if _, err = fmt.Sscanf(s, "%2d:%2d:%2d", &minutes, &seconds, &frames); err != nil {
+		return 0, 0, 0, err
+	}
+	return minutes, seconds, frames, nil
+}
+
+// parseGapDuration parses a Redbook mm:ss:ff timecode into a single time.Duration,
+// folding the frame component in at framesPerSecond.
+func parseGapDuration(s string) (time.Duration, error) {
+	minutes, seconds, frames, err := parseTimecode(s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second +
+		time.Duration(frames)*time.Second/framesPerSecond, nil
+}
+
 func (c *CueSheet) parseTitle(parameters []string) error {
 	if err := TitleCommand.validateParameters(len(parameters)); err != nil {
 		return fmt.Errorf("invalid TITLE parameters: %w", err)
 	}
-	nrTracks := len(c.Tracks)
+	nrTracks := c.trackCount()
 	if nrTracks == 0 {
 		// no tracks yet - try setting album title
 		if err := parseString(strings.Join(parameters, " "), &c.AlbumTitle); err != nil {
@@ -229,7 +418,7 @@ func (c *CueSheet) parseTitle(parameters []string) error {
 		}
 		return nil
 	}
-	currentTrack := c.Tracks[nrTracks-1]
+	currentTrack := c.lastTrack()
 	if err := parseString(strings.Join(parameters, " "), &currentTrack.Title); err != nil {
 		// current track title is already set
 		return fmt.Errorf("error parsing track %d TITLE: %w", nrTracks-1, err)
@@ -237,6 +426,117 @@ func (c *CueSheet) parseTitle(parameters []string) error {
 	return nil
 }
 
+func (c *CueSheet) parseCatalog(parameters []string) error {
+	if err := CatalogCommand.validateParameters(len(parameters)); err != nil {
+		return fmt.Errorf("invalid CATALOG parameters: %w", err)
+	}
+	catalog := parameters[0]
+	if len(catalog) != catalogLength {
+		return fmt.Errorf("catalog number must be %d digits, got %d", catalogLength, len(catalog))
+	}
+	if !catalogPattern.MatchString(catalog) {
+		return fmt.Errorf("catalog number must be numeric: %s", catalog)
+	}
+	return assignValue(catalog, &c.Catalog)
+}
+
+func (c *CueSheet) parseCDTextFile(parameters []string) error {
+	if err := CdTextFileCommand.validateParameters(len(parameters)); err != nil {
+		return fmt.Errorf("invalid CDTEXTFILE parameters: %w", err)
+	}
+	if err := parseString(strings.Join(parameters, " "), &c.CDTextFile); err != nil {
+		return fmt.Errorf("error parsing CDTEXTFILE parameters: %w", err)
+	}
+	return nil
+}
+
+func (c *CueSheet) parseSongwriter(parameters []string) error {
+	if err := SongwriterCommand.validateParameters(len(parameters)); err != nil {
+		return fmt.Errorf("invalid SONGWRITER parameters: %w", err)
+	}
+	nrTracks := c.trackCount()
+	if nrTracks == 0 {
+		// no tracks yet - try setting album songwriter
+		if err := parseString(strings.Join(parameters, " "), &c.AlbumSongwriter); err != nil {
+			return fmt.Errorf("error parsing album SONGWRITER: %w", err)
+		}
+		return nil
+	}
+	currentTrack := c.lastTrack()
+	if err := parseString(strings.Join(parameters, " "), &currentTrack.Songwriter); err != nil {
+		return fmt.Errorf("error parsing track %d SONGWRITER: %w", nrTracks-1, err)
+	}
+	return nil
+}
+
+func (c *CueSheet) parseISRC(parameters []string) error {
+	if err := IsrcCommand.validateParameters(len(parameters)); err != nil {
+		return fmt.Errorf("invalid ISRC parameters: %w", err)
+	}
+	currentTrack := c.lastTrack()
+	if currentTrack == nil {
+		return errors.New("ISRC command must follow a TRACK command")
+	}
+	isrc := strings.ToUpper(parameters[0])
+	if !isrcPattern.MatchString(isrc) {
+		return fmt.Errorf("invalid ISRC format: %s", isrc)
+	}
+	return assignValue(isrc, &currentTrack.ISRC)
+}
+
+func (c *CueSheet) parseFlags(parameters []string) error {
+	if err := FlagsCommand.validateParameters(len(parameters)); err != nil {
+		return fmt.Errorf("invalid FLAGS parameters: %w", err)
+	}
+	currentTrack := c.lastTrack()
+	if currentTrack == nil {
+		return errors.New("FLAGS command must follow a TRACK command")
+	}
+	if len(currentTrack.Flags) > 0 {
+		return fmt.Errorf("field already set: %v", currentTrack.Flags)
+	}
+	flags := make([]string, 0, len(parameters))
+	for _, flag := range parameters {
+		flag = strings.ToUpper(flag)
+		if !validFlags[flag] {
+			return fmt.Errorf("unknown flag: %s", flag)
+		}
+		flags = append(flags, flag)
+	}
+	currentTrack.Flags = flags
+	return nil
+}
+
+func (c *CueSheet) parsePregap(parameters []string) error {
+	if err := PregapCommand.validateParameters(len(parameters)); err != nil {
+		return fmt.Errorf("invalid PREGAP parameters: %w", err)
+	}
+	currentTrack := c.lastTrack()
+	if currentTrack == nil {
+		return errors.New("PREGAP command must follow a TRACK command")
+	}
+	duration, err := parseGapDuration(parameters[0])
+	if err != nil {
+		return fmt.Errorf("error parsing PREGAP duration: %w", err)
+	}
+	return assignValue(duration, &currentTrack.Pregap)
+}
+
+func (c *CueSheet) parsePostgap(parameters []string) error {
+	if err := PostgapCommand.validateParameters(len(parameters)); err != nil {
+		return fmt.Errorf("invalid POSTGAP parameters: %w", err)
+	}
+	currentTrack := c.lastTrack()
+	if currentTrack == nil {
+		return errors.New("POSTGAP command must follow a TRACK command")
+	}
+	duration, err := parseGapDuration(parameters[0])
+	if err != nil {
+		return fmt.Errorf("error parsing POSTGAP duration: %w", err)
+	}
+	return assignValue(duration, &currentTrack.Postgap)
+}
+
 func (c *CueSheet) parseRem(parameters []string) error {
 	var err error
 	command := parameters[0]
@@ -285,41 +585,70 @@ func (cmd *Command) validateParameters(parameters int) error {
 	return nil
 }
 
-// validate checks if the cue sheet has FILE and at least one TRACK command with INDEX 01.
+// validate checks that the cue sheet has at least one FILE, each with a name, a format,
+// and at least one TRACK command with INDEX 01.
 func (c *CueSheet) validate() error {
-	if c.FileName == "" {
+	if len(c.Files) == 0 {
 		return errors.New("missing file name")
 	}
-	if c.Format == "" {
+	for _, file := range c.Files {
+		if err := file.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FileRef) validate() error {
+	if f.Name == "" {
+		return errors.New("missing file name")
+	}
+	if f.Format == "" {
 		return errors.New("missing file format")
 	}
-	if len(c.Tracks) == 0 {
+	if len(f.Tracks) == 0 {
 		return errors.New("missing tracks")
 	}
-	if err := c.validateTracks(); err != nil {
+	if err := f.validateTracks(); err != nil {
 		return fmt.Errorf("invalid tracks: %w", err)
 	}
 	return nil
 }
 
-func (c *CueSheet) validateTracks() error {
-	for i, track := range c.Tracks {
+// validateTracks compares timestamps only within this FileRef, since indices restart at
+// the beginning of every file.
+func (f *FileRef) validateTracks() error {
+	for i, track := range f.Tracks {
 		if track.Type == "" {
 			return errors.New("missing track type")
 		}
-		if i < len(c.Tracks)-1 {
-			var (
-				timestamp = track.Index01.Timestamp
-				frame     = track.Index01.Frame
-
-				nextTrack     = c.Tracks[i+1]
-				nextTimestamp = nextTrack.Index01.Timestamp
-				nextFrame     = nextTrack.Index01.Frame
-			)
-			if timestamp > nextTimestamp || (timestamp == nextTimestamp && frame >= nextFrame) {
+		if track.HasIndex00 && compareIndexPoints(track.Index00, track.Index01) > 0 {
+			return fmt.Errorf("index 00 after index 01 in track %d", i+1)
+		}
+		if i < len(f.Tracks)-1 {
+			nextTrack := f.Tracks[i+1]
+			// a track's INDEX 00 marks where the previous track's audio actually ends
+			// on disc, so it takes precedence over INDEX 01 for the overlap check.
+			nextIndex := nextTrack.Index01
+			if nextTrack.HasIndex00 {
+				nextIndex = nextTrack.Index00
+			}
+			if compareIndexPoints(track.Index01, nextIndex) >= 0 {
 				return fmt.Errorf("overlapping indices in tracks %d and %d", i+1, i+2)
 			}
 		}
 	}
 	return nil
 }
+
+// compareIndexPoints returns a negative number if a is before b, a positive number if a
+// is after b, and 0 if they are equal.
+func compareIndexPoints(a, b IndexPoint) int {
+	if a.Timestamp != b.Timestamp {
+		if a.Timestamp < b.Timestamp {
+			return -1
+		}
+		return 1
+	}
+	return a.Frame - b.Frame
+}
@@ -24,6 +24,13 @@ type testCase struct {
 	expectedErr error
 }
 
+// singleFile builds the CueSheet.Files value for a cue sheet that declares exactly one
+// FILE command, mirroring the flattened FileName/Format/Tracks fields Parse also
+// populates for that case.
+func singleFile(name, format string, tracks []*Track) []*FileRef {
+	return []*FileRef{{Name: name, Format: format, Tracks: tracks}}
+}
+
 var minimalCueSheet = CueSheet{
 	FileName: "sample.flac",
 	Format:   "WAVE",
@@ -32,6 +39,11 @@ var minimalCueSheet = CueSheet{
 			Type: "AUDIO",
 		},
 	},
+	Files: singleFile("sample.flac", "WAVE", []*Track{
+		{
+			Type: "AUDIO",
+		},
+	}),
 }
 
 var allCueSheet = CueSheet{
@@ -56,6 +68,22 @@ var allCueSheet = CueSheet{
 			},
 		},
 	},
+	Files: singleFile("sample.flac", "WAVE", []*Track{
+		{
+			Type: "AUDIO",
+			Index01: IndexPoint{
+				Frame:     0,
+				Timestamp: time.Duration(1) * time.Second,
+			},
+		},
+		{
+			Type: "AUDIO",
+			Index01: IndexPoint{
+				Frame:     0,
+				Timestamp: time.Duration(1) * time.Minute,
+			},
+		},
+	}),
 }
 
 func TestParseCueSheets(t *testing.T) {
@@ -92,7 +120,7 @@ func TestParseFileCommand(t *testing.T) {
 		{
 			name:        "RepeatedFileCommand",
 			input:       open(t, path.Join("file", "repeated.cue")),
-			expectedErr: errors.New("field already set: WAVE"),
+			expectedErr: errors.New("missing tracks"),
 		},
 		{
 			name:        "InsufficientFileParams",
@@ -115,6 +143,38 @@ func TestParseFileCommand(t *testing.T) {
 	}
 }
 
+func TestParseMultiFileCommand(t *testing.T) {
+	tcs := []testCase{
+		{
+			name:  "GaplessAlbum",
+			input: open(t, path.Join("file", "multi.cue")),
+			expected: CueSheet{
+				Files: []*FileRef{
+					{
+						Name:   "track01.flac",
+						Format: "WAVE",
+						Tracks: []*Track{{Type: "AUDIO"}},
+					},
+					{
+						Name:   "track02.flac",
+						Format: "WAVE",
+						Tracks: []*Track{{Type: "AUDIO"}},
+					},
+				},
+				Tracks: []*Track{{Type: "AUDIO"}, {Type: "AUDIO"}},
+			},
+		},
+		{
+			name:        "MissingTracksInSecondFile",
+			input:       open(t, path.Join("file", "multi_missing_tracks.cue")),
+			expectedErr: errors.New("missing tracks"),
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, runTest(tc))
+	}
+}
+
 func TestParseTrackCommand(t *testing.T) {
 	tcs := []testCase{
 		{
@@ -208,6 +268,16 @@ func TestParsePerformerCommand(t *testing.T) {
 			input:       open(t, path.Join("performer", "empty.cue")),
 			expectedErr: errors.New("expected at least 1 parameters, got 0"),
 		},
+		{
+			name:  "TrackPerformer",
+			input: open(t, path.Join("performer", "track.cue")),
+			expected: CueSheet{
+				FileName: "sample.flac",
+				Format:   "WAVE",
+				Tracks:   []*Track{{Type: "AUDIO", Performer: "Sample Track Performer"}},
+				Files:    singleFile("sample.flac", "WAVE", []*Track{{Type: "AUDIO", Performer: "Sample Track Performer"}}),
+			},
+		},
 	}
 	for _, tc := range tcs {
 		t.Run(tc.name, runTest(tc))
@@ -250,6 +320,228 @@ func TestParseRemGenreCommand(t *testing.T) {
 	}
 }
 
+func TestParseCatalogCommand(t *testing.T) {
+	tcs := []testCase{
+		{
+			name:  "ValidCatalog",
+			input: open(t, path.Join("catalog", "valid.cue")),
+			expected: CueSheet{
+				Catalog:  "0123456789012",
+				FileName: "sample.flac",
+				Format:   "WAVE",
+				Tracks:   []*Track{{Type: "AUDIO"}},
+				Files:    singleFile("sample.flac", "WAVE", []*Track{{Type: "AUDIO"}}),
+			},
+		},
+		{
+			name:        "RepeatedCatalog",
+			input:       open(t, path.Join("catalog", "repeated.cue")),
+			expectedErr: errors.New("field already set: 0123456789012"),
+		},
+		{
+			name:        "InvalidCatalogLength",
+			input:       open(t, path.Join("catalog", "invalid_length.cue")),
+			expectedErr: errors.New("catalog number must be 13 digits, got 3"),
+		},
+		{
+			name:        "InvalidCatalogNumeric",
+			input:       open(t, path.Join("catalog", "invalid_numeric.cue")),
+			expectedErr: errors.New("catalog number must be numeric"),
+		},
+		{
+			name:        "InvalidCatalogSign",
+			input:       open(t, path.Join("catalog", "invalid_sign.cue")),
+			expectedErr: errors.New("catalog number must be numeric"),
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, runTest(tc))
+	}
+}
+
+func TestParseCDTextFileCommand(t *testing.T) {
+	tcs := []testCase{
+		{
+			name:  "ValidCDTextFile",
+			input: open(t, path.Join("cdtextfile", "valid.cue")),
+			expected: CueSheet{
+				CDTextFile: "sample.cdt",
+				FileName:   "sample.flac",
+				Format:     "WAVE",
+				Tracks:     []*Track{{Type: "AUDIO"}},
+				Files:      singleFile("sample.flac", "WAVE", []*Track{{Type: "AUDIO"}}),
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, runTest(tc))
+	}
+}
+
+func TestParseSongwriterCommand(t *testing.T) {
+	tcs := []testCase{
+		{
+			name:  "AlbumSongwriter",
+			input: open(t, path.Join("songwriter", "album.cue")),
+			expected: CueSheet{
+				AlbumSongwriter: "Sample Songwriter",
+				FileName:        "sample.flac",
+				Format:          "WAVE",
+				Tracks:          []*Track{{Type: "AUDIO"}},
+				Files:           singleFile("sample.flac", "WAVE", []*Track{{Type: "AUDIO"}}),
+			},
+		},
+		{
+			name:  "TrackSongwriter",
+			input: open(t, path.Join("songwriter", "track.cue")),
+			expected: CueSheet{
+				FileName: "sample.flac",
+				Format:   "WAVE",
+				Tracks:   []*Track{{Type: "AUDIO", Songwriter: "Sample Track Songwriter"}},
+				Files:    singleFile("sample.flac", "WAVE", []*Track{{Type: "AUDIO", Songwriter: "Sample Track Songwriter"}}),
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, runTest(tc))
+	}
+}
+
+func TestParseISRCCommand(t *testing.T) {
+	tcs := []testCase{
+		{
+			name:  "ValidISRC",
+			input: open(t, path.Join("isrc", "valid.cue")),
+			expected: CueSheet{
+				FileName: "sample.flac",
+				Format:   "WAVE",
+				Tracks:   []*Track{{Type: "AUDIO", ISRC: "USRC17607839"}},
+				Files:    singleFile("sample.flac", "WAVE", []*Track{{Type: "AUDIO", ISRC: "USRC17607839"}}),
+			},
+		},
+		{
+			name:        "InvalidISRC",
+			input:       open(t, path.Join("isrc", "invalid.cue")),
+			expectedErr: errors.New("invalid ISRC format: NOTANISRC"),
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, runTest(tc))
+	}
+}
+
+func TestParseFlagsCommand(t *testing.T) {
+	tcs := []testCase{
+		{
+			name:  "ValidFlags",
+			input: open(t, path.Join("flags", "valid.cue")),
+			expected: CueSheet{
+				FileName: "sample.flac",
+				Format:   "WAVE",
+				Tracks:   []*Track{{Type: "AUDIO", Flags: []string{"DCP", "4CH"}}},
+				Files:    singleFile("sample.flac", "WAVE", []*Track{{Type: "AUDIO", Flags: []string{"DCP", "4CH"}}}),
+			},
+		},
+		{
+			name:        "UnknownFlag",
+			input:       open(t, path.Join("flags", "invalid.cue")),
+			expectedErr: errors.New("unknown flag: BOGUS"),
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, runTest(tc))
+	}
+}
+
+func TestParsePregapPostgapCommand(t *testing.T) {
+	tcs := []testCase{
+		{
+			name:  "Pregap",
+			input: open(t, path.Join("pregap", "valid.cue")),
+			expected: CueSheet{
+				FileName: "sample.flac",
+				Format:   "WAVE",
+				Tracks:   []*Track{{Type: "AUDIO", Pregap: 2 * time.Minute}},
+				Files:    singleFile("sample.flac", "WAVE", []*Track{{Type: "AUDIO", Pregap: 2 * time.Minute}}),
+			},
+		},
+		{
+			name:  "Postgap",
+			input: open(t, path.Join("postgap", "valid.cue")),
+			expected: CueSheet{
+				FileName: "sample.flac",
+				Format:   "WAVE",
+				Tracks:   []*Track{{Type: "AUDIO", Postgap: 2 * time.Minute}},
+				Files:    singleFile("sample.flac", "WAVE", []*Track{{Type: "AUDIO", Postgap: 2 * time.Minute}}),
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, runTest(tc))
+	}
+}
+
+func TestParseIndex00Command(t *testing.T) {
+	tcs := []testCase{
+		{
+			name:  "ValidIndex00",
+			input: open(t, path.Join("index00", "valid.cue")),
+			expected: CueSheet{
+				FileName: "sample.flac",
+				Format:   "WAVE",
+				Tracks: []*Track{{
+					Type:       "AUDIO",
+					HasIndex00: true,
+					Index00:    IndexPoint{Timestamp: 0},
+					Index01:    IndexPoint{Timestamp: 2 * time.Minute},
+				}},
+				Files: singleFile("sample.flac", "WAVE", []*Track{{
+					Type:       "AUDIO",
+					HasIndex00: true,
+					Index00:    IndexPoint{Timestamp: 0},
+					Index01:    IndexPoint{Timestamp: 2 * time.Minute},
+				}}),
+			},
+		},
+		{
+			name:        "Index00AfterIndex01",
+			input:       open(t, path.Join("index00", "after_index01.cue")),
+			expectedErr: errors.New("index 00 after index 01 in track 1"),
+		},
+		{
+			name:        "IndexBeforeTrack",
+			input:       open(t, path.Join("index00", "before_track.cue")),
+			expectedErr: errors.New("INDEX command must follow a TRACK command"),
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, runTest(tc))
+	}
+}
+
+func TestParseErrorStructuredFields(t *testing.T) {
+	_, err := Parse(open(t, path.Join("isrc", "invalid.cue")))
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	require.Equal(t, 3, parseErr.Line)
+	require.Equal(t, 6, parseErr.Column)
+	require.Equal(t, "ISRC", parseErr.Command)
+	require.Equal(t, 1, parseErr.TrackNumber)
+	require.Contains(t, parseErr.Unwrap().Error(), "invalid ISRC format: NOTANISRC")
+}
+
+func TestParseErrorWrapsValidationFailures(t *testing.T) {
+	_, err := Parse(open(t, path.Join("index00", "overlapping.cue")))
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	require.Equal(t, 2, parseErr.TrackNumber)
+	require.Contains(t, parseErr.Unwrap().Error(), "overlapping indices in tracks 1 and 2")
+}
+
 func runTest(tc testCase) func(t *testing.T) {
 	return func(t *testing.T) {
 		cueSheet, err := Parse(tc.input)
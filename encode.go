@@ -0,0 +1,134 @@
+package cuesheetgo
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+// indentUnit is the per-level indentation used when encoding, following the CDRWIN
+// convention of two spaces per nesting level (FILE -> TRACK -> INDEX/flags).
+const indentUnit = "  "
+
+// Encode serializes c to canonical CUE sheet syntax and writes it to w.
+func Encode(w io.Writer, c *CueSheet) error {
+	for _, line := range encodeLines(c) {
+		if _, err := io.WriteString(w, line+"\n"); err != nil {
+			return fmt.Errorf("error writing cue sheet: %w", err)
+		}
+	}
+	return nil
+}
+
+// Marshal serializes c to canonical CUE sheet syntax.
+func Marshal(c *CueSheet) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, c); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeLines(c *CueSheet) []string {
+	var lines []string
+	appendLine := func(indent int, format string, args ...any) {
+		lines = append(lines, strings.Repeat(indentUnit, indent)+fmt.Sprintf(format, args...))
+	}
+
+	if c.Catalog != "" {
+		appendLine(0, "CATALOG %s", c.Catalog)
+	}
+	if c.CDTextFile != "" {
+		appendLine(0, "CDTEXTFILE %s", quoteIfNeeded(c.CDTextFile))
+	}
+	if c.AlbumPerformer != "" {
+		appendLine(0, "PERFORMER %s", quoteIfNeeded(c.AlbumPerformer))
+	}
+	if c.AlbumTitle != "" {
+		appendLine(0, "TITLE %s", quoteIfNeeded(c.AlbumTitle))
+	}
+	if c.AlbumSongwriter != "" {
+		appendLine(0, "SONGWRITER %s", quoteIfNeeded(c.AlbumSongwriter))
+	}
+	if c.Genre != "" {
+		appendLine(0, "REM GENRE %s", quoteIfNeeded(c.Genre))
+	}
+	if c.Date != "" {
+		appendLine(0, "REM DATE %s", quoteIfNeeded(c.Date))
+	}
+
+	trackNr := 0
+	for _, file := range c.Files {
+		appendLine(0, "FILE %s %s", quoteIfNeeded(file.Name), file.Format)
+		for _, track := range file.Tracks {
+			trackNr++
+			appendLine(1, "TRACK %02d %s", trackNr, track.Type)
+			if track.Title != "" {
+				appendLine(2, "TITLE %s", quoteIfNeeded(track.Title))
+			}
+			if track.Performer != "" {
+				appendLine(2, "PERFORMER %s", quoteIfNeeded(track.Performer))
+			}
+			if track.Songwriter != "" {
+				appendLine(2, "SONGWRITER %s", quoteIfNeeded(track.Songwriter))
+			}
+			if track.ISRC != "" {
+				appendLine(2, "ISRC %s", track.ISRC)
+			}
+			if len(track.Flags) > 0 {
+				appendLine(2, "FLAGS %s", strings.Join(track.Flags, " "))
+			}
+			if track.Pregap != 0 {
+				appendLine(2, "PREGAP %s", formatGapDuration(track.Pregap))
+			}
+			if track.HasIndex00 {
+				appendLine(2, "INDEX 00 %s", formatIndexPoint(track.Index00))
+			}
+			appendLine(2, "INDEX 01 %s", formatIndexPoint(track.Index01))
+			if track.Postgap != 0 {
+				appendLine(2, "POSTGAP %s", formatGapDuration(track.Postgap))
+			}
+		}
+	}
+	return lines
+}
+
+// quoteIfNeeded wraps s in double quotes only when it contains whitespace, matching how
+// cue sheets in the wild quote strings.
+func quoteIfNeeded(s string) string {
+	if strings.ContainsAny(s, " \t") {
+		return `"` + s + `"`
+	}
+	return s
+}
+
+// formatIndexPoint renders an IndexPoint as a Redbook mm:ss:ff timecode. Timestamp and
+// Frame are kept separate on IndexPoint specifically so this can't drift from nanosecond
+// rounding the way folding frames into a single time.Duration would.
+func formatIndexPoint(p IndexPoint) string {
+	minutes := int(p.Timestamp / time.Minute)
+	seconds := int((p.Timestamp % time.Minute) / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", minutes, seconds, p.Frame)
+}
+
+// formatGapDuration renders a PREGAP/POSTGAP time.Duration as a Redbook mm:ss:ff
+// timecode, the inverse of parseGapDuration.
+func formatGapDuration(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	rem := d % time.Minute
+	seconds := int(rem / time.Second)
+	frameRemainder := rem % time.Second
+	frames := int(math.Round(float64(frameRemainder) / float64(time.Second) * framesPerSecond))
+	if frames == framesPerSecond {
+		frames = 0
+		seconds++
+		if seconds == 60 {
+			seconds = 0
+			minutes++
+		}
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", minutes, seconds, frames)
+}
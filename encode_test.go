@@ -0,0 +1,79 @@
+package cuesheetgo
+
+import (
+	"bytes"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeRoundTrip(t *testing.T) {
+	var paths []string
+	require.NoError(t, fs.WalkDir(testdataFS, "testdata", func(p string, d fs.DirEntry, err error) error {
+		require.NoError(t, err)
+		if !d.IsDir() {
+			paths = append(paths, p)
+		}
+		return nil
+	}))
+
+	for _, p := range paths {
+		t.Run(p, func(t *testing.T) {
+			data, err := testdataFS.ReadFile(p)
+			require.NoError(t, err)
+
+			original, err := Parse(bytes.NewReader(data))
+			if err != nil {
+				t.Skipf("fixture does not represent a valid cue sheet: %v", err)
+			}
+
+			encoded, err := Marshal(original)
+			require.NoError(t, err)
+
+			roundTripped, err := Parse(bytes.NewReader(encoded))
+			require.NoError(t, err)
+			require.Equal(t, *original, *roundTripped)
+		})
+	}
+}
+
+func TestEncodeEmitsZeroIndex00(t *testing.T) {
+	cueSheet := &CueSheet{
+		Files: []*FileRef{{
+			Name:   "sample.flac",
+			Format: "WAVE",
+			Tracks: []*Track{{
+				Type:       "AUDIO",
+				HasIndex00: true,
+				Index00:    IndexPoint{Timestamp: 0},
+				Index01:    IndexPoint{Timestamp: 2 * time.Minute},
+			}},
+		}},
+	}
+
+	encoded, err := Marshal(cueSheet)
+	require.NoError(t, err)
+	require.Contains(t, string(encoded), "INDEX 00 00:00:00\n")
+}
+
+func TestFormatIndexPoint(t *testing.T) {
+	point := IndexPoint{Timestamp: time.Duration(1)*time.Minute + time.Duration(2)*time.Second, Frame: 37}
+	require.Equal(t, "01:02:37", formatIndexPoint(point))
+}
+
+func TestFormatGapDuration(t *testing.T) {
+	duration, err := parseGapDuration("01:02:37")
+	require.NoError(t, err)
+	require.Equal(t, "01:02:37", formatGapDuration(duration))
+}
+
+func TestEncodeAllFieldsCueSheet(t *testing.T) {
+	encoded, err := Marshal(&allCueSheet)
+	require.NoError(t, err)
+
+	roundTripped, err := Parse(bytes.NewReader(encoded))
+	require.NoError(t, err)
+	require.Equal(t, allCueSheet, *roundTripped)
+}
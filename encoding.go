@@ -0,0 +1,53 @@
+package cuesheetgo
+
+import (
+	"bufio"
+	"io"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// Options configures Parse's less common behavior.
+type Options struct {
+	// Encoding decodes the input when it carries no byte-order mark. Cue sheets from
+	// Windows ripping tools are frequently legacy Shift-JIS or CP1252 without a BOM, so
+	// callers who know which one to expect can pass e.g. japanese.ShiftJIS or
+	// charmap.Windows1252. Ignored if the input does have a BOM.
+	Encoding encoding.Encoding
+}
+
+// decodeReader sniffs r for a UTF-8, UTF-16 LE, or UTF-16 BE byte-order mark and, if
+// found, strips it and wraps r in the matching decoder. Without a BOM, it falls back to
+// opts.Encoding when set, otherwise it assumes the input is already UTF-8.
+func decodeReader(r io.Reader, opts Options) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	bom, err := br.Peek(3)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case len(bom) >= 3 && bom[0] == 0xEF && bom[1] == 0xBB && bom[2] == 0xBF:
+		if _, err := br.Discard(3); err != nil {
+			return nil, err
+		}
+		return br, nil
+	case len(bom) >= 2 && bom[0] == 0xFF && bom[1] == 0xFE:
+		if _, err := br.Discard(2); err != nil {
+			return nil, err
+		}
+		return transform.NewReader(br, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewDecoder()), nil
+	case len(bom) >= 2 && bom[0] == 0xFE && bom[1] == 0xFF:
+		if _, err := br.Discard(2); err != nil {
+			return nil, err
+		}
+		return transform.NewReader(br, unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewDecoder()), nil
+	}
+
+	if opts.Encoding != nil {
+		return transform.NewReader(br, opts.Encoding.NewDecoder()), nil
+	}
+	return br, nil
+}
@@ -0,0 +1,58 @@
+package cuesheetgo
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestParseBOMEncodings(t *testing.T) {
+	tcs := []struct {
+		name          string
+		file          string
+		expectedTitle string
+	}{
+		{name: "UTF8", file: "utf8.cue", expectedTitle: "Café Album"},
+		{name: "UTF16LE", file: "utf16le.cue", expectedTitle: "Café Album"},
+		{name: "UTF16BE", file: "utf16be.cue", expectedTitle: "Café Album"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cueSheet, err := Parse(open(t, path.Join("encoding", tc.file)))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTitle, cueSheet.AlbumTitle)
+		})
+	}
+}
+
+func TestParseWithOptionsFallbackEncoding(t *testing.T) {
+	tcs := []struct {
+		name          string
+		file          string
+		encoding      Options
+		expectedTitle string
+	}{
+		{
+			name:          "Windows1252",
+			file:          "windows1252.cue",
+			encoding:      Options{Encoding: charmap.Windows1252},
+			expectedTitle: "Café Album",
+		},
+		{
+			name:          "ShiftJIS",
+			file:          "shiftjis.cue",
+			encoding:      Options{Encoding: japanese.ShiftJIS},
+			expectedTitle: "アルバム",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cueSheet, err := ParseWithOptions(open(t, path.Join("encoding", tc.file)), tc.encoding)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTitle, cueSheet.AlbumTitle)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package cuesheetgo
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/scbash/cuesheetgo/tagreader"
+)
+
+// EnrichFromAudio fills in empty album- and track-level fields from the tags of the
+// audio file the cue sheet references, using r to read them. It never overwrites a field
+// the cue sheet already specified, and only works for single-FILE cue sheets since the
+// audio tags describe one file. dir is the directory FileName is relative to.
+func (c *CueSheet) EnrichFromAudio(dir string, r tagreader.TagReader) error {
+	if len(c.Files) != 1 {
+		return errors.New("EnrichFromAudio requires a single-FILE cue sheet")
+	}
+
+	tags, err := r.ReadTags(filepath.Join(dir, c.FileName))
+	if err != nil {
+		return fmt.Errorf("error reading audio tags: %w", err)
+	}
+
+	if c.AlbumPerformer == "" {
+		c.AlbumPerformer = tags.AlbumArtist
+	}
+	if c.AlbumTitle == "" {
+		c.AlbumTitle = tags.AlbumTitle
+	}
+	if c.Date == "" {
+		c.Date = tags.Date
+	}
+	if c.Genre == "" {
+		c.Genre = tags.Genre
+	}
+
+	tracks := c.Files[0].Tracks
+	for i, track := range tracks {
+		if track.Title == "" && i < len(tags.TrackTitles) {
+			track.Title = tags.TrackTitles[i]
+		}
+	}
+
+	if len(tags.Durations) == 0 {
+		return nil
+	}
+	fileDuration := sumDurations(tags.Durations)
+	lastTrack := tracks[len(tracks)-1]
+	if lastTrack.Index01.Timestamp >= fileDuration {
+		return fmt.Errorf("last track INDEX 01 (%s) is not before the file duration (%s)", lastTrack.Index01.Timestamp, fileDuration)
+	}
+	return nil
+}
+
+func sumDurations(durations []time.Duration) time.Duration {
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+	return total
+}
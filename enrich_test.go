@@ -0,0 +1,75 @@
+package cuesheetgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scbash/cuesheetgo/tagreader"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTagReader struct {
+	tags tagreader.Tags
+	err  error
+}
+
+func (f fakeTagReader) ReadTags(path string) (tagreader.Tags, error) {
+	return f.tags, f.err
+}
+
+func TestEnrichFromAudio(t *testing.T) {
+	cueSheet := &CueSheet{
+		FileName: "sample.flac",
+		Format:   "WAVE",
+		Files: []*FileRef{
+			{
+				Name:   "sample.flac",
+				Format: "WAVE",
+				Tracks: []*Track{
+					{Type: "AUDIO", Index01: IndexPoint{Timestamp: 0}},
+					{Type: "AUDIO", Title: "Already Set", Index01: IndexPoint{Timestamp: time.Minute}},
+				},
+			},
+		},
+	}
+
+	reader := fakeTagReader{tags: tagreader.Tags{
+		AlbumArtist: "Tag Artist",
+		AlbumTitle:  "Tag Album",
+		Genre:       "Rock",
+		TrackTitles: []string{"First Track", "Second Track"},
+		Durations:   []time.Duration{90 * time.Second, 90 * time.Second},
+	}}
+
+	require.NoError(t, cueSheet.EnrichFromAudio("/music", reader))
+	require.Equal(t, "Tag Artist", cueSheet.AlbumPerformer)
+	require.Equal(t, "Tag Album", cueSheet.AlbumTitle)
+	require.Equal(t, "Rock", cueSheet.Genre)
+	require.Equal(t, "First Track", cueSheet.Files[0].Tracks[0].Title)
+	require.Equal(t, "Already Set", cueSheet.Files[0].Tracks[1].Title)
+}
+
+func TestEnrichFromAudioRejectsMultiFile(t *testing.T) {
+	cueSheet := &CueSheet{Files: []*FileRef{{}, {}}}
+	err := cueSheet.EnrichFromAudio("/music", tagreader.NoopReader{})
+	require.ErrorContains(t, err, "single-FILE cue sheet")
+}
+
+func TestEnrichFromAudioValidatesAgainstFileDuration(t *testing.T) {
+	cueSheet := &CueSheet{
+		FileName: "sample.flac",
+		Format:   "WAVE",
+		Files: []*FileRef{
+			{
+				Name:   "sample.flac",
+				Format: "WAVE",
+				Tracks: []*Track{
+					{Type: "AUDIO", Index01: IndexPoint{Timestamp: 2 * time.Minute}},
+				},
+			},
+		},
+	}
+	reader := fakeTagReader{tags: tagreader.Tags{Durations: []time.Duration{time.Minute}}}
+	err := cueSheet.EnrichFromAudio("/music", reader)
+	require.ErrorContains(t, err, "is not before the file duration")
+}
@@ -0,0 +1,28 @@
+package cuesheetgo
+
+import "fmt"
+
+// ParseError carries machine-readable context about where a Parse failure occurred: the
+// source position of the offending line, the command being parsed, and - when the
+// failure happened inside a TRACK block - the number of that track. It also wraps
+// whole-sheet validation failures (Command "validation"), reported at the final line with
+// the last track seen. Callers can recover it with errors.As.
+type ParseError struct {
+	Line        int
+	Column      int
+	Command     string
+	TrackNumber int
+	Err         error
+}
+
+func (e *ParseError) Error() string {
+	context := e.Command
+	if e.TrackNumber > 0 {
+		context = fmt.Sprintf("TRACK %02d, %s", e.TrackNumber, e.Command)
+	}
+	return fmt.Sprintf("at line %d, col %d, in %s: %s", e.Line, e.Column, context, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
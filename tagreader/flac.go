@@ -0,0 +1,47 @@
+//go:build flac
+
+package tagreader
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/dhowden/tag"
+)
+
+// FlacReader reads Vorbis comment tags from FLAC files via dhowden/tag. It only builds
+// with the "flac" build tag, so the core parser stays free of this dependency unless a
+// caller opts in.
+type FlacReader struct{}
+
+// ReadTags reports the album-level tags embedded in the FLAC file at path. dhowden/tag
+// only exposes the file's own Vorbis comments, not per-track splits or stream duration, so
+// TrackTitles and Durations are always left empty - callers enriching a single-FILE cue
+// sheet that spans many tracks still get the album fields, but per-track titles and the
+// file-duration cross-check only run against a TagReader that can supply them.
+func (FlacReader) ReadTags(path string) (Tags, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Tags{}, err
+	}
+	defer f.Close()
+
+	metadata, err := tag.ReadFrom(f)
+	if err != nil {
+		return Tags{}, err
+	}
+
+	var date string
+	if year := metadata.Year(); year != 0 {
+		date = strconv.Itoa(year)
+	}
+
+	_, trackCount := metadata.Track()
+	return Tags{
+		AlbumArtist: metadata.AlbumArtist(),
+		AlbumTitle:  metadata.Album(),
+		Date:        date,
+		Genre:       metadata.Genre(),
+		TrackCount:  trackCount,
+	}, nil
+}
@@ -0,0 +1,9 @@
+package tagreader
+
+// NoopReader is a TagReader that never reads anything and always returns an empty Tags.
+// It is the zero-dependency default, used when no audio-tag backend has been built in.
+type NoopReader struct{}
+
+func (NoopReader) ReadTags(path string) (Tags, error) {
+	return Tags{}, nil
+}
@@ -0,0 +1,23 @@
+// Package tagreader abstracts reading metadata tags out of an audio file, so that
+// cuesheetgo can cross-validate a parsed CUE sheet against the file it references
+// without forcing a specific audio-tagging library (or any dependency at all) on callers
+// who don't need it.
+package tagreader
+
+import "time"
+
+// Tags holds the metadata embedded in an audio file that is relevant to a CueSheet.
+type Tags struct {
+	AlbumArtist string
+	AlbumTitle  string
+	Date        string
+	Genre       string
+	TrackCount  int
+	TrackTitles []string
+	Durations   []time.Duration
+}
+
+// TagReader reads the Tags embedded in the audio file at path.
+type TagReader interface {
+	ReadTags(path string) (Tags, error)
+}